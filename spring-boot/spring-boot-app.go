@@ -24,6 +24,7 @@ import (
 
 	"github.com/go-spring/go-spring-parent/spring-logger"
 	"github.com/go-spring/go-spring/spring-core"
+	"github.com/go-spring/spring-core/dync"
 )
 
 const (
@@ -59,6 +60,7 @@ type application struct {
 	appCtx      ApplicationContext // 应用上下文
 	cfgLocation []string           // 配置文件目录
 	configReady func()             // 配置文件已就绪
+	refresher   *dync.Refresher    // 动态配置热更新
 }
 
 // newApplication application 的构造函数
@@ -97,6 +99,12 @@ func (app *application) Start() {
 		(*ApplicationContext)(nil), (*SpringCore.SpringContext)(nil),
 	)
 
+	// 注册动态配置热更新事件
+	app.appCtx.RegisterBean(newRefresherEvent(app)).AsInterface((*ApplicationEvent)(nil))
+
+	// 注册 dync 属性的 Prometheus 指标事件
+	app.appCtx.RegisterBean(&metricsEvent{app: app}).AsInterface((*ApplicationEvent)(nil))
+
 	// 依赖注入、属性绑定、Bean 初始化
 	app.appCtx.AutoWireBeans()
 
@@ -172,10 +180,20 @@ func (app *application) loadProfileConfig(profile string) {
 
 		if ss := strings.Split(configLocation, ":"); len(ss) == 1 {
 			result = NewDefaultPropertySource(ss[0]).Load(profile)
+			if result == nil {
+				result = make(map[string]interface{})
+			}
+
+			// application.toml 优先级高于 application.properties
+			for k, v := range NewTomlPropertySource(ss[0]).Load(profile) {
+				result[k] = v
+			}
 		} else {
 			switch ss[0] {
 			case "k8s":
 				result = NewConfigMapPropertySource(ss[1]).Load(profile)
+			case "vault":
+				result = NewVaultPropertySource(app.appCtx, ss[1]).Load(profile)
 			}
 		}
 
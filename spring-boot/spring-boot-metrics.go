@@ -0,0 +1,81 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package SpringBoot
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-spring/go-spring-parent/spring-logger"
+	"github.com/go-spring/go-spring/spring-core"
+	"github.com/go-spring/spring-core/dync/metrics"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	SpringMetricsEnable = "spring.metrics.enable" // 是否启用 dync 属性的 Prometheus 指标
+	SpringMetricsPort   = "spring.metrics.port"   // /metrics 监听端口，默认 DefaultMetricsPort
+
+	DefaultMetricsPort = 9090
+)
+
+// metricsEvent 在 spring.metrics.enable=true 时，把全部 dync.* 属性注册为
+// Prometheus 指标，并拉起一个 /metrics HTTP 端点。ApplicationEvent 的派发顺序
+// 并不保证与注册顺序一致，所以它不依赖 refresherEvent 先完成 bean 扫描，
+// 而是自己扫描一遍；refresher.Scan 的 Register 是幂等的，重复扫描没有副作用。
+type metricsEvent struct {
+	app *application
+}
+
+// OnStartApplication 发现并注册 dync.* 属性指标，启动 /metrics 端点
+func (e *metricsEvent) OnStartApplication(ctx ApplicationContext) {
+
+	keys := []string{SpringMetricsEnable}
+	if strings.ToLower(SpringCore.GetStringProperty(ctx, keys...)) != "true" {
+		return
+	}
+
+	scanBeans(ctx, e.app.refresher)
+
+	registry := metrics.NewRegistry(e.app.refresher)
+	registry.Discover()
+
+	port := DefaultMetricsPort
+	keys = []string{SpringMetricsPort}
+	if s := SpringCore.GetStringProperty(ctx, keys...); s != "" {
+		if p, err := strconv.Atoi(s); err == nil {
+			port = p
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		addr := fmt.Sprintf(":%d", port)
+		SpringLogger.Infof("metrics endpoint listening on %s", addr)
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			SpringLogger.Errorf("metrics endpoint error %s", err)
+		}
+	}()
+}
+
+// OnStopApplication /metrics 端点随进程退出而结束，无需额外处理
+func (e *metricsEvent) OnStopApplication(ctx ApplicationContext) {
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package SpringBoot
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// flattenProperties 将嵌套的 map/slice 结构展开为点号分隔的属性键，
+// 数组元素使用 [index] 形式命名，如 Db.Slaves[0].Host。数组一律按反射的
+// Slice/Array 处理，这样无论元素是 []interface{} 还是 TOML 的
+// []map[string]interface{}（数组表）都能正确递归。
+func flattenProperties(prefix string, v interface{}, result map[string]interface{}) {
+	if v == nil {
+		if prefix != "" {
+			result[prefix] = v
+		}
+		return
+	}
+
+	if m, ok := v.(map[string]interface{}); ok {
+		for k, elem := range m {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			flattenProperties(key, elem, result)
+		}
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			key := fmt.Sprintf("%s[%d]", prefix, i)
+			flattenProperties(key, rv.Index(i).Interface(), result)
+		}
+	default:
+		if prefix != "" {
+			result[prefix] = v
+		}
+	}
+}
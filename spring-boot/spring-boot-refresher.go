@@ -0,0 +1,101 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package SpringBoot
+
+import (
+	"strings"
+
+	"github.com/go-spring/go-spring-parent/spring-logger"
+	"github.com/go-spring/spring-core/dync"
+)
+
+// refresherEvent 把 dync.Refresher 接入 SpringBoot 的应用事件体系，应用启动时
+// 为配置源建立热更新监听；k8s: 前缀的配置源监听对应的 ConfigMap，不带前缀的按
+// 本地文件目录处理，与 loadProfileConfig 使用同一套属性文件加载规则；其它前缀
+// （如 vault:）的配置源不建立监听。
+type refresherEvent struct {
+	app *application
+}
+
+// OnStartApplication 扫描所有已装配的 bean，注册其 dync.* 字段，
+// 再为每个 cfgLocation 建立热更新监听
+func (e *refresherEvent) OnStartApplication(ctx ApplicationContext) {
+	scanBeans(ctx, e.app.refresher)
+
+	for _, configLocation := range e.app.cfgLocation {
+		ss := strings.Split(configLocation, ":")
+
+		switch {
+		case len(ss) == 2 && ss[0] == "k8s":
+			name := ss[1]
+			namespace := dync.InClusterNamespace()
+			if err := e.app.refresher.WatchConfigMap(namespace, name); err != nil {
+				SpringLogger.Errorf("watch configmap %s error %s", name, err)
+			}
+		case len(ss) == 1:
+			dir := ss[0]
+			if err := e.app.refresher.WatchFile(dir, func() (map[string]interface{}, error) {
+				return e.loadDir(dir), nil
+			}); err != nil {
+				SpringLogger.Errorf("watch file %s error %s", dir, err)
+			}
+		}
+	}
+}
+
+// scanBeans 收集容器中的全部 bean，反射其字段，把 dync.* 属性注册到 refresher，
+// 这样用户无需手写任何注册代码即可让 dync.* 字段参与热更新。refresher.Scan
+// 内部的 Register 是幂等的，所以这个函数可以被多个 ApplicationEvent 各自调用，
+// 不依赖彼此的事件派发顺序。
+func scanBeans(ctx ApplicationContext, refresher *dync.Refresher) {
+	var beans []interface{}
+	ctx.CollectBeans(&beans)
+	for _, bean := range beans {
+		refresher.Scan(bean)
+	}
+}
+
+// loadDir 按 loadProfileConfig 相同的优先级重新加载本地目录下的配置
+func (e *refresherEvent) loadDir(dir string) map[string]interface{} {
+	profiles := []string{""}
+	if profile := e.app.appCtx.GetProfile(); profile != "" {
+		profiles = append(profiles, strings.ToLower(profile))
+	}
+
+	result := make(map[string]interface{})
+	for _, profile := range profiles {
+		for k, v := range NewDefaultPropertySource(dir).Load(profile) {
+			result[k] = v
+		}
+		for k, v := range NewTomlPropertySource(dir).Load(profile) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// OnStopApplication 热更新监听随进程退出而结束，无需额外处理
+func (e *refresherEvent) OnStopApplication(ctx ApplicationContext) {
+}
+
+// newRefresherEvent refresherEvent 的构造函数
+func newRefresherEvent(app *application) *refresherEvent {
+	if app.refresher == nil {
+		app.refresher = dync.NewRefresher()
+	}
+	return &refresherEvent{app: app}
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package SpringBoot
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-spring/go-spring-parent/spring-logger"
+)
+
+// TomlPropertySource 从 application.toml / application-<profile>.toml 文件加载配置属性
+type TomlPropertySource struct {
+	configLocation string // 配置文件所在目录
+}
+
+// NewTomlPropertySource TomlPropertySource 的构造函数
+func NewTomlPropertySource(configLocation string) *TomlPropertySource {
+	return &TomlPropertySource{configLocation: configLocation}
+}
+
+// Load 加载 profile 对应的 toml 配置文件，文件不存在时返回 nil
+func (p *TomlPropertySource) Load(profile string) map[string]interface{} {
+
+	fileName := "application.toml"
+	if profile != "" {
+		fileName = "application-" + profile + ".toml"
+	}
+
+	file := filepath.Join(p.configLocation, fileName)
+	if _, err := os.Stat(file); err != nil {
+		return nil // 文件不存在，忽略
+	}
+
+	var raw map[string]interface{}
+	if _, err := toml.DecodeFile(file, &raw); err != nil {
+		SpringLogger.Errorf("parse %s error %s", file, err)
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	flattenProperties("", raw, result)
+	return result
+}
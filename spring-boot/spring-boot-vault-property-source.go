@@ -0,0 +1,164 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package SpringBoot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/go-spring/go-spring-parent/spring-logger"
+	"github.com/go-spring/go-spring/spring-core"
+)
+
+const (
+	VAULT_ADDR  = "VAULT_ADDR"  // Vault 服务地址环境变量
+	VAULT_TOKEN = "VAULT_TOKEN" // Vault 静态 Token 环境变量
+
+	SpringVaultRoleId   = "spring.vault.role-id"   // AppRole 认证的 role-id
+	SpringVaultSecretId = "spring.vault.secret-id" // AppRole 认证的 secret-id
+)
+
+// VaultPropertySource 从 HashiCorp Vault 的 KV v2 引擎加载配置属性
+type VaultPropertySource struct {
+	appCtx ApplicationContext // 应用上下文，用于读取 AppRole 认证属性
+	path   string             // 形如 secret/data/myapp 的密钥路径
+}
+
+// NewVaultPropertySource VaultPropertySource 的构造函数
+func NewVaultPropertySource(appCtx ApplicationContext, path string) *VaultPropertySource {
+	return &VaultPropertySource{appCtx: appCtx, path: path}
+}
+
+// Load 从 Vault 加载 profile 对应的配置属性，profile 为空串时加载默认路径
+func (p *VaultPropertySource) Load(profile string) map[string]interface{} {
+
+	path := p.path
+	if profile != "" {
+		path = path + "-" + profile
+	}
+
+	addr := os.Getenv(VAULT_ADDR)
+	if addr == "" {
+		SpringLogger.Warnf("vault:%s skipped, %s not set", path, VAULT_ADDR)
+		return nil
+	}
+
+	token, err := p.token(addr)
+	if err != nil {
+		SpringLogger.Errorf("vault login error %s", err)
+		return nil
+	}
+
+	data, err := p.readSecret(addr, token, path)
+	if err != nil {
+		SpringLogger.Errorf("vault read %s error %s", path, err)
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	flattenProperties("", data, result)
+	return result
+}
+
+// token 获取访问 Vault 所使用的 Token，优先使用 VAULT_TOKEN，否则走 AppRole 认证
+func (p *VaultPropertySource) token(addr string) (string, error) {
+
+	if token := os.Getenv(VAULT_TOKEN); token != "" {
+		return token, nil
+	}
+
+	keys := []string{SpringVaultRoleId}
+	roleId := SpringCore.GetStringProperty(p.appCtx, keys...)
+
+	keys = []string{SpringVaultSecretId}
+	secretId := SpringCore.GetStringProperty(p.appCtx, keys...)
+
+	if roleId == "" || secretId == "" {
+		return "", fmt.Errorf("%s not set and approle credentials missing", VAULT_TOKEN)
+	}
+
+	body, err := json.Marshal(struct {
+		RoleId   string `json:"role_id"`
+		SecretId string `json:"secret_id"`
+	}{RoleId: roleId, SecretId: secretId})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.Post(addr+"/v1/auth/approle/login", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if err = json.Unmarshal(b, &result); err != nil {
+		return "", err
+	}
+	if result.Auth.ClientToken == "" {
+		return "", fmt.Errorf("approle login failed: %s", string(b))
+	}
+	return result.Auth.ClientToken, nil
+}
+
+// readSecret 读取 KV v2 密钥，返回 data.data 部分
+func (p *VaultPropertySource) readSecret(addr, token, path string) (map[string]interface{}, error) {
+
+	req, err := http.NewRequest(http.MethodGet, addr+"/v1/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault responded %d: %s", resp.StatusCode, string(b))
+	}
+
+	var result struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return result.Data.Data, nil
+}
@@ -39,6 +39,10 @@ type beanAssembly interface {
 	WireStructField(v reflect.Value, tag string, parent reflect.Value, field string)
 }
 
+// BeanAssembly 是 beanAssembly 的导出别名，使得 ProviderArg 的回调函数类型
+// 能够在 core 包之外被书写，从而让容器外的用户代码真正可以构造 *ProviderArg。
+type BeanAssembly = beanAssembly
+
 type Arg interface{}
 
 type ArgList struct {
@@ -122,12 +126,46 @@ func (argList *ArgList) getArgValue(t reflect.Type, arg Arg, assembly beanAssemb
 		{
 			return tArg.call(assembly)
 		}
+	case *ValueArg:
+		{
+			v := tArg.v
+			if !v.Type().AssignableTo(t) {
+				panic(fmt.Sprintf("getArgValue::: can't assign value of type %s to %s", v.Type(), t))
+			}
+			return v
+		}
+	case *ProviderArg:
+		{
+			v := tArg.fn(assembly)
+			if !v.Type().AssignableTo(t) {
+				panic(fmt.Sprintf("getArgValue::: can't assign value of type %s to %s", v.Type(), t))
+			}
+			return v
+		}
 	}
 	panic("getArgValue:::")
 }
 
-//type ValueArg struct {
-//}
+// ValueArg 持有一个预先计算好的值，既不做属性绑定也不做依赖注入，
+// 适合用来传常量或测试替身。
+type ValueArg struct {
+	v reflect.Value
+}
+
+// NewValueArg ValueArg 的构造函数
+func NewValueArg(v interface{}) *ValueArg {
+	return &ValueArg{v: reflect.ValueOf(v)}
+}
+
+// ProviderArg 延迟对着容器计算出一个值，例如根据运行时状态挑选一个动态命名的 Bean
+type ProviderArg struct {
+	fn func(assembly BeanAssembly) reflect.Value
+}
+
+// NewProviderArg ProviderArg 的构造函数
+func NewProviderArg(fn func(assembly BeanAssembly) reflect.Value) *ProviderArg {
+	return &ProviderArg{fn: fn}
+}
 
 // OptionArg Option 函数的绑定参数
 type OptionArg struct {
@@ -145,8 +183,9 @@ func validOptionFunc(fnType reflect.Type) bool {
 	return fnType.Kind() == reflect.Func && fnType.NumOut() == 1
 }
 
-// NewOptionArg OptionArg 的构造函数，args 是 Option 函数的一般参数绑定
-func NewOptionArg(fn interface{}, strArgs ...string) *OptionArg {
+// NewOptionArg OptionArg 的构造函数，args 是 Option 函数的一般参数绑定，
+// 既可以是字符串形式的绑定表达式，也可以是 *ValueArg、*ProviderArg
+func NewOptionArg(fn interface{}, args ...Arg) *OptionArg {
 
 	var (
 		file string
@@ -174,11 +213,6 @@ func NewOptionArg(fn interface{}, strArgs ...string) *OptionArg {
 		panic(errors.New("option func must be func(...)option"))
 	}
 
-	args := make([]Arg, len(strArgs))
-	for i, arg := range strArgs {
-		args[i] = arg
-	}
-
 	return &OptionArg{
 		fn:      fn,
 		argList: NewArgList(fnType, false, args),
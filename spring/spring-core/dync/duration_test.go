@@ -0,0 +1,60 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-spring/spring-base/assert"
+	"github.com/go-spring/spring-base/json"
+	"github.com/go-spring/spring-core/conf"
+	"github.com/go-spring/spring-core/dync"
+)
+
+func TestDuration(t *testing.T) {
+
+	var u dync.Duration
+	assert.Equal(t, u.Value(), time.Duration(0))
+
+	param := conf.BindParam{
+		Key:  "duration",
+		Path: "duration",
+		Tag: conf.ParsedTag{
+			Key: "duration",
+		},
+	}
+
+	p := conf.Map(nil)
+	err := u.Validate(p, param)
+	assert.Error(t, err, "bind time.Duration error; .* resolve property \"duration\" error; property \"duration\" not exist")
+	err = u.Refresh(p, param)
+	assert.Error(t, err, "bind time.Duration error; .* resolve property \"duration\" error; property \"duration\" not exist")
+
+	_ = p.Set("duration", "3s")
+	err = u.Validate(p, param)
+	assert.Nil(t, err)
+	assert.Equal(t, u.Value(), time.Duration(0))
+
+	err = u.Refresh(p, param)
+	assert.Nil(t, err)
+	assert.Equal(t, u.Value(), 3*time.Second)
+
+	b, err := json.Marshal(&u)
+	assert.Nil(t, err)
+	assert.Equal(t, string(b), "\"3s\"")
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-spring/spring-base/atomic"
+	"github.com/go-spring/spring-core/conf"
+)
+
+// An Enum is an atomic string value restricted to a fixed allow-list that
+// can be dynamic refreshed.
+type Enum struct {
+	v     atomic.Value
+	allow []string
+}
+
+// NewEnum returns an Enum whose value is restricted to allow.
+func NewEnum(allow ...string) *Enum {
+	return &Enum{allow: allow}
+}
+
+// Value returns the stored string value.
+func (x *Enum) Value() string {
+	s, _ := x.v.Load().(string)
+	return s
+}
+
+// Validate validates the property value against the allow-list.
+func (x *Enum) Validate(p *conf.Properties, param conf.BindParam) error {
+	var s string
+	if err := p.Bind(&s, conf.Param(param)); err != nil {
+		return err
+	}
+	return x.validateAllowed(s)
+}
+
+// Refresh refreshes the stored value.
+func (x *Enum) Refresh(p *conf.Properties, param conf.BindParam) error {
+	var s string
+	if err := p.Bind(&s, conf.Param(param)); err != nil {
+		return err
+	}
+	if err := x.validateAllowed(s); err != nil {
+		return err
+	}
+	x.v.Store(s)
+	return nil
+}
+
+// MarshalJSON returns the JSON encoding of x.
+func (x *Enum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.Value())
+}
+
+// validateAllowed reports whether s is one of the allowed values.
+func (x *Enum) validateAllowed(s string) error {
+	for _, a := range x.allow {
+		if a == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("validate failed on \"enum(%s)\" for value %q", strings.Join(x.allow, "|"), s)
+}
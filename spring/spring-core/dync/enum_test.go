@@ -0,0 +1,60 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/spring-base/assert"
+	"github.com/go-spring/spring-base/json"
+	"github.com/go-spring/spring-core/conf"
+	"github.com/go-spring/spring-core/dync"
+)
+
+func TestEnum(t *testing.T) {
+
+	u := dync.NewEnum("dev", "test", "prod")
+	assert.Equal(t, u.Value(), "")
+
+	param := conf.BindParam{
+		Key:  "env",
+		Path: "enum",
+		Tag: conf.ParsedTag{
+			Key: "env",
+		},
+	}
+
+	p := conf.Map(nil)
+	err := u.Validate(p, param)
+	assert.Error(t, err, "bind string error; .* resolve property \"env\" error; property \"env\" not exist")
+
+	_ = p.Set("env", "staging")
+	err = u.Validate(p, param)
+	assert.Error(t, err, "validate failed on \"enum\\(dev\\|test\\|prod\\)\" for value \"staging\"")
+	err = u.Refresh(p, param)
+	assert.Error(t, err, "validate failed on \"enum\\(dev\\|test\\|prod\\)\" for value \"staging\"")
+	assert.Equal(t, u.Value(), "")
+
+	_ = p.Set("env", "prod")
+	err = u.Refresh(p, param)
+	assert.Nil(t, err)
+	assert.Equal(t, u.Value(), "prod")
+
+	b, err := json.Marshal(u)
+	assert.Nil(t, err)
+	assert.Equal(t, string(b), "\"prod\"")
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync
+
+import (
+	"encoding/json"
+
+	"github.com/go-spring/spring-base/atomic"
+	"github.com/go-spring/spring-core/conf"
+)
+
+// A Map is an atomic map[K]V value that can be dynamic refreshed.
+type Map[K comparable, V any] struct {
+	v atomic.Value
+}
+
+// Value returns the stored map value.
+func (x *Map[K, V]) Value() map[K]V {
+	m, _ := x.v.Load().(map[K]V)
+	return m
+}
+
+// Validate validates the property value.
+func (x *Map[K, V]) Validate(p *conf.Properties, param conf.BindParam) error {
+	var m map[K]V
+	return p.Bind(&m, conf.Param(param))
+}
+
+// Refresh refreshes the stored value.
+func (x *Map[K, V]) Refresh(p *conf.Properties, param conf.BindParam) error {
+	var m map[K]V
+	if err := p.Bind(&m, conf.Param(param)); err != nil {
+		return err
+	}
+	x.v.Store(m)
+	return nil
+}
+
+// MarshalJSON returns the JSON encoding of x.
+func (x *Map[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.Value())
+}
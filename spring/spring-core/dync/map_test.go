@@ -0,0 +1,60 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/spring-base/assert"
+	"github.com/go-spring/spring-base/json"
+	"github.com/go-spring/spring-core/conf"
+	"github.com/go-spring/spring-core/dync"
+)
+
+func TestMap(t *testing.T) {
+
+	var u dync.Map[string, int]
+	assert.Equal(t, u.Value(), map[string]int(nil))
+
+	param := conf.BindParam{
+		Key:  "m",
+		Path: "map",
+		Tag: conf.ParsedTag{
+			Key: "m",
+		},
+	}
+
+	p := conf.Map(nil)
+	err := u.Validate(p, param)
+	assert.Error(t, err, "bind map\\[string\\]int error; .* resolve property \"m\" error; property \"m\" not exist")
+	err = u.Refresh(p, param)
+	assert.Error(t, err, "bind map\\[string\\]int error; .* resolve property \"m\" error; property \"m\" not exist")
+
+	_ = p.Set("m.a", 1)
+	_ = p.Set("m.b", 2)
+	err = u.Validate(p, param)
+	assert.Nil(t, err)
+	assert.Equal(t, u.Value(), map[string]int(nil))
+
+	err = u.Refresh(p, param)
+	assert.Nil(t, err)
+	assert.Equal(t, u.Value(), map[string]int{"a": 1, "b": 2})
+
+	b, err := json.Marshal(&u)
+	assert.Nil(t, err)
+	assert.Equal(t, string(b), "{\"a\":1,\"b\":2}")
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics 把 dync.Refresher 管理的属性暴露为 Prometheus 指标。
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/go-spring/spring-core/dync"
+)
+
+// Kind 描述一个 dync 属性应该以何种 Prometheus 指标形式暴露。
+type Kind int
+
+const (
+	KindGauge Kind = iota // 数值类型，直接映射为 Gauge
+	KindLabel             // Bool、Enum 等离散值类型，使用带 value= 标签的 Gauge
+	KindInfo              // 其它不适合做数值比较的类型，使用 info 指标，固定值为 1
+)
+
+// refreshTotal 统计每个 key 的刷新尝试次数，按结果分类。
+var refreshTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "spring_dync_refresh_total",
+	Help: "Total number of dync property refresh attempts, labeled by result.",
+}, []string{"key", "result"})
+
+func init() {
+	prometheus.MustRegister(refreshTotal)
+}
+
+// Registry 把 Refresher 管理的 dync 属性注册为 Prometheus 指标。
+type Registry struct {
+	refresher *dync.Refresher
+	gauges    map[string]*prometheus.GaugeVec
+}
+
+// NewRegistry Registry 的构造函数，refresher 用于订阅属性的变更与刷新结果。
+func NewRegistry(refresher *dync.Refresher) *Registry {
+	reg := &Registry{refresher: refresher, gauges: make(map[string]*prometheus.GaugeVec)}
+	refresher.OnRefresh(reg.onRefresh)
+	return reg
+}
+
+// Discover 为 refresher 当前已注册的全部属性自动创建并注册指标，指标形式根据
+// 属性的具体类型和 JSON 快照自动推断，用户不需要为自己的 dync.* 字段手写任何注册代码。
+func (reg *Registry) Discover() {
+	for key, prop := range reg.refresher.Properties() {
+		reg.Register(key, kindOf(prop), prop)
+	}
+}
+
+// Register 为 key 对应的属性创建并注册一个 Prometheus 指标，kind 决定指标的
+// 形式，注册后立即用 prop 的当前值完成一次初始化，而不是等到下一次刷新。
+func (reg *Registry) Register(key string, kind Kind, prop dync.Property) {
+
+	labels := []string{}
+	name := gaugeName(key)
+	if kind != KindGauge {
+		labels = []string{"value"}
+	}
+	if kind == KindInfo {
+		name += "_info"
+	}
+
+	g := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: name,
+		Help: fmt.Sprintf("Current value of dync property %q.", key),
+	}, labels)
+	prometheus.MustRegister(g)
+	reg.gauges[key] = g
+
+	set := reg.setter(kind, g)
+	set(prop) // 初始值，避免 /metrics 在下一次刷新前一直缺失该指标
+
+	reg.refresher.OnRefresh(func(_ string, err error) {
+		if err == nil {
+			set(prop)
+		}
+	})
+}
+
+// setter 返回一个按 kind 把 prop 当前值写入 g 的函数。
+func (reg *Registry) setter(kind Kind, g *prometheus.GaugeVec) func(prop dync.Property) {
+	return func(prop dync.Property) {
+		b, err := prop.MarshalJSON()
+		if err != nil {
+			return
+		}
+		switch kind {
+		case KindGauge:
+			var f float64
+			if json.Unmarshal(b, &f) == nil {
+				g.WithLabelValues().Set(f)
+			}
+		case KindLabel, KindInfo:
+			g.Reset()
+			g.WithLabelValues(jsonString(b)).Set(1)
+		}
+	}
+}
+
+// onRefresh 在每次刷新尝试后递增 spring_dync_refresh_total 计数器。
+func (reg *Registry) onRefresh(key string, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	refreshTotal.WithLabelValues(key, result).Inc()
+}
+
+// kindOf 根据属性的具体类型和 JSON 快照推断它应该暴露成哪种指标形式。
+func kindOf(prop dync.Property) Kind {
+	switch prop.(type) {
+	case *dync.Bool, *dync.Enum:
+		return KindLabel
+	}
+
+	b, err := prop.MarshalJSON()
+	if err != nil {
+		return KindInfo
+	}
+
+	var f float64
+	if json.Unmarshal(b, &f) == nil {
+		return KindGauge
+	}
+	return KindInfo
+}
+
+// jsonString 把一段 JSON 编码的值渲染成适合做标签值的字符串。
+func jsonString(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return string(b)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// gaugeName 把属性的点号路径规整为合法的 Prometheus 指标名。
+func gaugeName(key string) string {
+	name := strings.ToLower(key)
+	name = strings.NewReplacer(".", "_", "-", "_", "[", "_", "]", "").Replace(name)
+	return "spring_dync_" + name
+}
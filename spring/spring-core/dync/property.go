@@ -0,0 +1,33 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync
+
+import "github.com/go-spring/spring-core/conf"
+
+// Property 是所有 dync 动态属性（Bool、Int32、Duration、StringSlice、Map、Enum ...）
+// 共同实现的接口，Refresher 借助它对异构字段做统一的校验、刷新与快照。
+type Property interface {
+
+	// Validate 校验 p 中 param 对应的属性值，不改变属性的当前值。
+	Validate(p *conf.Properties, param conf.BindParam) error
+
+	// Refresh 用 p 中 param 对应的属性值刷新属性的当前值。
+	Refresh(p *conf.Properties, param conf.BindParam) error
+
+	// MarshalJSON 返回属性当前值的 JSON 编码。
+	MarshalJSON() ([]byte, error)
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/go-spring/spring-core/conf"
+)
+
+// entry 是一条已注册的动态属性及其绑定参数。
+type entry struct {
+	key   string // 配置属性 key，可能有多个字段绑定到同一个 key
+	prop  Property
+	param conf.BindParam
+}
+
+// Refresher 把配置源的变化原子地应用到所有已注册的 dync 属性上：
+// 先对所有属性执行 Validate，只有全部通过才会提交 Refresh，
+// 因此一次失败的校验不会导致部分字段被更新。
+type Refresher struct {
+	mu           sync.Mutex
+	entries      map[string]*entry // 以 param.Path 为 key，同一个配置 key 绑定到多个字段时互不覆盖
+	watchers     map[string][]func(old, new interface{})
+	refreshHooks []func(key string, err error)
+}
+
+// NewRefresher Refresher 的构造函数。
+func NewRefresher() *Refresher {
+	return &Refresher{
+		entries:  make(map[string]*entry),
+		watchers: make(map[string][]func(old, new interface{})),
+	}
+}
+
+// Register 注册一个动态属性，key 是它在配置中的属性路径，param.Path 唯一标识
+// 它所绑定的字段。多个字段可以绑定到同一个 key，各自都会在 Reload 时被刷新；
+// 同一个字段重复 Register（例如 bean 被扫描了不止一次）是幂等的。
+func (r *Refresher) Register(key string, prop Property, param conf.BindParam) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[param.Path] = &entry{key: key, prop: prop, param: param}
+}
+
+// Properties 返回当前已注册的全部动态属性，key 为 Register 时传入的 param.Path。
+func (r *Refresher) Properties() map[string]Property {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := make(map[string]Property, len(r.entries))
+	for path, e := range r.entries {
+		result[path] = e.prop
+	}
+	return result
+}
+
+// OnPropertyChange 订阅 key 对应属性刷新成功后的变更事件。
+func (r *Refresher) OnPropertyChange(key string, fn func(old, new interface{})) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watchers[key] = append(r.watchers[key], fn)
+}
+
+// OnRefresh 订阅每一次按 key 尝试刷新的结果，err 为 nil 表示刷新成功。
+func (r *Refresher) OnRefresh(fn func(key string, err error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refreshHooks = append(r.refreshHooks, fn)
+}
+
+// Reload 用新的 Properties 原子地刷新所有已注册的属性。
+func (r *Refresher) Reload(p *conf.Properties) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 先对全部字段执行校验，任何一个失败都不提交本次刷新。
+	for _, e := range r.entries {
+		if err := e.prop.Validate(p, e.param); err != nil {
+			r.notifyRefresh(e.key, err)
+			return fmt.Errorf("validate %s error: %w", e.param.Path, err)
+		}
+	}
+
+	for _, e := range r.entries {
+		old := r.snapshot(e.prop)
+		if err := e.prop.Refresh(p, e.param); err != nil {
+			r.notifyRefresh(e.key, err)
+			return fmt.Errorf("refresh %s error: %w", e.param.Path, err)
+		}
+		r.notifyRefresh(e.key, nil)
+		new := r.snapshot(e.prop)
+		for _, fn := range r.watchers[e.key] {
+			fn(old, new)
+		}
+	}
+	return nil
+}
+
+// notifyRefresh 通知所有已订阅的刷新结果回调。
+func (r *Refresher) notifyRefresh(key string, err error) {
+	for _, fn := range r.refreshHooks {
+		fn(key, err)
+	}
+}
+
+// snapshot 拍下属性当前值的快照，供变更事件携带 old/new 值使用。
+func (r *Refresher) snapshot(prop Property) interface{} {
+	b, err := prop.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	var v interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/go-spring/spring-core/conf"
+	"github.com/go-spring/spring-core/log"
+)
+
+// inClusterNamespaceFile 是 Kubernetes 自动挂载到每个 Pod 里的命名空间文件。
+const inClusterNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// InClusterNamespace 返回当前 Pod 所在的命名空间，读取不到时回退到 "default"，
+// 供 WatchConfigMap 在调用方没有显式指定命名空间时使用。
+func InClusterNamespace() string {
+	b, err := ioutil.ReadFile(inClusterNamespaceFile)
+	if err != nil {
+		return "default"
+	}
+	if ns := strings.TrimSpace(string(b)); ns != "" {
+		return ns
+	}
+	return "default"
+}
+
+// WatchConfigMap 监听 namespace 下名为 name 的 ConfigMap，MODIFIED 事件发生时重新 Reload。
+func (r *Refresher) WatchConfigMap(namespace, name string) error {
+
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := clientSet.CoreV1().ConfigMaps(namespace).Watch(context.Background(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for event := range watcher.ResultChan() {
+			if event.Type != "MODIFIED" {
+				continue
+			}
+			cm, ok := event.Object.(*corev1.ConfigMap)
+			if !ok {
+				continue
+			}
+			data := make(map[string]interface{}, len(cm.Data))
+			for k, v := range cm.Data {
+				data[k] = v
+			}
+			if err := r.Reload(conf.Map(data)); err != nil {
+				log.Errorf("dync refresh from configmap %s error: %s", name, err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// WatchFile 监听本地目录 dir，目录下的文件发生写入时调用 load 重新读取配置并 Reload，
+// 供本地开发时与 k8s ConfigMap 场景保持一致的热更新体验。
+func (r *Refresher) WatchFile(dir string, load func() (map[string]interface{}, error)) error {
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	if err = watcher.Add(dir); err != nil {
+		return err
+	}
+
+	go func() {
+		for event := range watcher.Events {
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			data, err := load()
+			if err != nil {
+				log.Errorf("dync refresh from file %s error: %s", event.Name, err)
+				continue
+			}
+			if err = r.Reload(conf.Map(data)); err != nil {
+				log.Errorf("dync refresh from file %s error: %s", event.Name, err)
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-spring/spring-core/conf"
+)
+
+// Scan 反射遍历 bean 的导出字段，把其中实现了 Property 接口的字段注册到
+// Refresher，字段需要像 conf.Bind 那样用 value:"${a.b.c}" 标签声明属性路径。
+// bean 必须是指向结构体的指针，调用方通常在 AutoWireBeans 完成后，对每个
+// 已装配好的 bean 调用一次。
+func (r *Refresher) Scan(bean interface{}) {
+	v := reflect.ValueOf(bean)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	v = v.Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		if !fv.CanAddr() || !fv.Addr().CanInterface() {
+			continue
+		}
+
+		prop, ok := fv.Addr().Interface().(Property)
+		if !ok {
+			continue
+		}
+
+		key := parseValueTag(field.Tag.Get("value"))
+		if key == "" {
+			continue
+		}
+
+		param := conf.BindParam{
+			Key:  key,
+			Path: t.Name() + "." + field.Name,
+			Tag:  conf.ParsedTag{Key: key},
+		}
+		r.Register(key, prop, param)
+	}
+}
+
+// parseValueTag 从形如 "${a.b.c}" 的标签中提取属性路径，标签格式不对时返回空串。
+func parseValueTag(tag string) string {
+	tag = strings.TrimSpace(tag)
+	if !strings.HasPrefix(tag, "${") || !strings.HasSuffix(tag, "}") {
+		return ""
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(tag, "${"), "}")
+}
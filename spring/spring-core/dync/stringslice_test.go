@@ -0,0 +1,59 @@
+/*
+ * Copyright 2012-2019 the original author or authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dync_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/spring-base/assert"
+	"github.com/go-spring/spring-base/json"
+	"github.com/go-spring/spring-core/conf"
+	"github.com/go-spring/spring-core/dync"
+)
+
+func TestStringSlice(t *testing.T) {
+
+	var u dync.StringSlice
+	assert.Equal(t, u.Value(), []string(nil))
+
+	param := conf.BindParam{
+		Key:  "slice",
+		Path: "stringSlice",
+		Tag: conf.ParsedTag{
+			Key: "slice",
+		},
+	}
+
+	p := conf.Map(nil)
+	err := u.Validate(p, param)
+	assert.Error(t, err, "bind \\[\\]string error; .* resolve property \"slice\" error; property \"slice\" not exist")
+	err = u.Refresh(p, param)
+	assert.Error(t, err, "bind \\[\\]string error; .* resolve property \"slice\" error; property \"slice\" not exist")
+
+	_ = p.Set("slice", "a,b,c")
+	err = u.Validate(p, param)
+	assert.Nil(t, err)
+	assert.Equal(t, u.Value(), []string(nil))
+
+	err = u.Refresh(p, param)
+	assert.Nil(t, err)
+	assert.Equal(t, u.Value(), []string{"a", "b", "c"})
+
+	b, err := json.Marshal(&u)
+	assert.Nil(t, err)
+	assert.Equal(t, string(b), "[\"a\",\"b\",\"c\"]")
+}